@@ -0,0 +1,332 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import (
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// PEM block types used by the Marshal*PEM/Unmarshal*PEM functions.
+const (
+	pemTypePublicKey  = "FALCON-DET1024 PUBLIC KEY"
+	pemTypePrivateKey = "FALCON-DET1024 PRIVATE KEY"
+	pemTypeSignature  = "FALCON-DET1024 SIGNATURE"
+)
+
+// PEM header keys.
+const (
+	headerForm        = "Form"
+	headerSaltVersion = "Salt-Version"
+	headerCRC24       = "Crc24"
+)
+
+// Values of the Form header on a signature PEM block.
+const (
+	formHeaderCompressed = "compressed"
+	formHeaderCT         = "ct"
+)
+
+var (
+	// ErrArmorType is returned when a PEM block has an unexpected type.
+	ErrArmorType = errors.New("falcon: unexpected PEM block type")
+	// ErrArmorChecksum is returned when a PEM block's CRC-24 header does
+	// not match the checksum of its decoded body, indicating corruption.
+	ErrArmorChecksum = errors.New("falcon: PEM block failed CRC-24 checksum")
+)
+
+// crc24Init and crc24Poly are the CRC-24 parameters used by OpenPGP armor
+// (RFC 4880 section 6.1). We reuse them so the checksum is familiar to
+// anyone who has worked with PGP-armored material.
+const (
+	crc24Init = 0xB704CE
+	crc24Poly = 0x1864CFB
+)
+
+func crc24(data []byte) uint32 {
+	crc := uint32(crc24Init)
+	for _, b := range data {
+		crc ^= uint32(b) << 16
+		for i := 0; i < 8; i++ {
+			crc <<= 1
+			if crc&0x1000000 != 0 {
+				crc ^= crc24Poly
+			}
+		}
+	}
+	return crc & 0xFFFFFF
+}
+
+func crc24Hex(data []byte) string {
+	return fmt.Sprintf("%06X", crc24(data))
+}
+
+func verifyCRC24(data []byte, want string) error {
+	if crc24Hex(data) != want {
+		return ErrArmorChecksum
+	}
+	return nil
+}
+
+// MarshalPEM encodes pk as a PEM block of type "FALCON-DET1024 PUBLIC KEY",
+// with a CRC-24 checksum header to catch transmission corruption.
+func (pk PublicKey) MarshalPEM() ([]byte, error) {
+	if len(pk) != PublicKeySize {
+		return nil, ErrBadPublicKeyLength
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:    pemTypePublicKey,
+		Headers: map[string]string{headerCRC24: crc24Hex(pk)},
+		Bytes:   pk,
+	}), nil
+}
+
+// UnmarshalPublicKeyPEM decodes a PublicKey previously produced by
+// PublicKey.MarshalPEM.
+func UnmarshalPublicKeyPEM(data []byte) (PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != pemTypePublicKey {
+		return nil, ErrArmorType
+	}
+	if err := verifyCRC24(block.Bytes, block.Headers[headerCRC24]); err != nil {
+		return nil, err
+	}
+	if len(block.Bytes) != PublicKeySize {
+		return nil, fmt.Errorf("%w: %d", ErrBadPublicKeyLength, len(block.Bytes))
+	}
+	pk := make(PublicKey, PublicKeySize)
+	copy(pk, block.Bytes)
+	return pk, nil
+}
+
+// MarshalPEM encodes sk as a PEM block of type "FALCON-DET1024 PRIVATE KEY",
+// with a CRC-24 checksum header to catch transmission corruption.
+func (sk PrivateKey) MarshalPEM() ([]byte, error) {
+	if len(sk) != ExpandedPrivateKeySize {
+		return nil, ErrBadPrivateKeyLength
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:    pemTypePrivateKey,
+		Headers: map[string]string{headerCRC24: crc24Hex(sk)},
+		Bytes:   sk,
+	}), nil
+}
+
+// UnmarshalPrivateKeyPEM decodes a PrivateKey previously produced by
+// PrivateKey.MarshalPEM.
+func UnmarshalPrivateKeyPEM(data []byte) (PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != pemTypePrivateKey {
+		return nil, ErrArmorType
+	}
+	if err := verifyCRC24(block.Bytes, block.Headers[headerCRC24]); err != nil {
+		return nil, err
+	}
+	if len(block.Bytes) != ExpandedPrivateKeySize {
+		return nil, fmt.Errorf("%w: %d", ErrBadPrivateKeyLength, len(block.Bytes))
+	}
+	sk := make(PrivateKey, ExpandedPrivateKeySize)
+	copy(sk, block.Bytes)
+	return sk, nil
+}
+
+// MarshalPEM encodes sig as a PEM block of type "FALCON-DET1024 SIGNATURE",
+// recording its salt version and compressed form so decoders can dispatch,
+// plus a CRC-24 checksum header to catch transmission corruption.
+func (sig CompressedSignature) MarshalPEM() ([]byte, error) {
+	return pem.EncodeToMemory(&pem.Block{
+		Type: pemTypeSignature,
+		Headers: map[string]string{
+			headerForm:        formHeaderCompressed,
+			headerSaltVersion: strconv.Itoa(sig.SaltVersion()),
+			headerCRC24:       crc24Hex(sig),
+		},
+		Bytes: sig,
+	}), nil
+}
+
+// MarshalPEM encodes sig as a PEM block of type "FALCON-DET1024 SIGNATURE",
+// recording its salt version and CT form so decoders can dispatch, plus a
+// CRC-24 checksum header to catch transmission corruption.
+func (sig CTSignature) MarshalPEM() ([]byte, error) {
+	return pem.EncodeToMemory(&pem.Block{
+		Type: pemTypeSignature,
+		Headers: map[string]string{
+			headerForm:        formHeaderCT,
+			headerSaltVersion: strconv.Itoa(sig.SaltVersion()),
+			headerCRC24:       crc24Hex(sig[:]),
+		},
+		Bytes: sig[:],
+	}), nil
+}
+
+// UnmarshalSignaturePEM decodes a signature PEM block produced by
+// CompressedSignature.MarshalPEM or CTSignature.MarshalPEM. form reports
+// which of compressed/ct was returned; only the corresponding one of
+// compressed/ct is populated.
+func UnmarshalSignaturePEM(data []byte) (form SignatureForm, compressed CompressedSignature, ct CTSignature, err error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != pemTypeSignature {
+		err = ErrArmorType
+		return
+	}
+	if cerr := verifyCRC24(block.Bytes, block.Headers[headerCRC24]); cerr != nil {
+		err = cerr
+		return
+	}
+
+	switch block.Headers[headerForm] {
+	case formHeaderCT:
+		form = FormCT
+		if len(block.Bytes) != len(ct) {
+			err = fmt.Errorf("falcon: bad CT signature length %d", len(block.Bytes))
+			return
+		}
+		copy(ct[:], block.Bytes)
+	default:
+		form = FormCompressed
+		compressed = append(CompressedSignature(nil), block.Bytes...)
+	}
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (pk PublicKey) MarshalBinary() ([]byte, error) {
+	if len(pk) != PublicKeySize {
+		return nil, ErrBadPublicKeyLength
+	}
+	return append([]byte(nil), pk...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (pk *PublicKey) UnmarshalBinary(data []byte) error {
+	if len(data) != PublicKeySize {
+		return fmt.Errorf("%w: %d", ErrBadPublicKeyLength, len(data))
+	}
+	*pk = append(PublicKey(nil), data...)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the PEM encoding.
+func (pk PublicKey) MarshalText() ([]byte, error) {
+	return pk.MarshalPEM()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (pk *PublicKey) UnmarshalText(text []byte) error {
+	parsed, err := UnmarshalPublicKeyPEM(text)
+	if err != nil {
+		return err
+	}
+	*pk = parsed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (sk PrivateKey) MarshalBinary() ([]byte, error) {
+	if len(sk) != ExpandedPrivateKeySize {
+		return nil, ErrBadPrivateKeyLength
+	}
+	return append([]byte(nil), sk...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (sk *PrivateKey) UnmarshalBinary(data []byte) error {
+	if len(data) != ExpandedPrivateKeySize {
+		return fmt.Errorf("%w: %d", ErrBadPrivateKeyLength, len(data))
+	}
+	*sk = append(PrivateKey(nil), data...)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the PEM encoding.
+func (sk PrivateKey) MarshalText() ([]byte, error) {
+	return sk.MarshalPEM()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (sk *PrivateKey) UnmarshalText(text []byte) error {
+	parsed, err := UnmarshalPrivateKeyPEM(text)
+	if err != nil {
+		return err
+	}
+	*sk = parsed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (sig CompressedSignature) MarshalBinary() ([]byte, error) {
+	return append([]byte(nil), sig...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (sig *CompressedSignature) UnmarshalBinary(data []byte) error {
+	*sig = append(CompressedSignature(nil), data...)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the PEM encoding.
+func (sig CompressedSignature) MarshalText() ([]byte, error) {
+	return sig.MarshalPEM()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (sig *CompressedSignature) UnmarshalText(text []byte) error {
+	form, compressed, _, err := UnmarshalSignaturePEM(text)
+	if err != nil {
+		return err
+	}
+	if form != FormCompressed {
+		return fmt.Errorf("falcon: expected compressed signature PEM block, got CT")
+	}
+	*sig = compressed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (sig CTSignature) MarshalBinary() ([]byte, error) {
+	return append([]byte(nil), sig[:]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (sig *CTSignature) UnmarshalBinary(data []byte) error {
+	if len(data) != len(*sig) {
+		return fmt.Errorf("falcon: bad CT signature length %d", len(data))
+	}
+	copy(sig[:], data)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the PEM encoding.
+func (sig CTSignature) MarshalText() ([]byte, error) {
+	return sig.MarshalPEM()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (sig *CTSignature) UnmarshalText(text []byte) error {
+	form, _, ct, err := UnmarshalSignaturePEM(text)
+	if err != nil {
+		return err
+	}
+	if form != FormCT {
+		return fmt.Errorf("falcon: expected CT signature PEM block, got compressed")
+	}
+	*sig = ct
+	return nil
+}
@@ -0,0 +1,253 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import (
+	"bytes"
+	"encoding/pem"
+	"errors"
+	"testing"
+)
+
+func fixturePublicKey() PublicKey {
+	pk := make(PublicKey, PublicKeySize)
+	for i := range pk {
+		pk[i] = byte(i)
+	}
+	return pk
+}
+
+func fixturePrivateKey() PrivateKey {
+	sk := make(PrivateKey, ExpandedPrivateKeySize)
+	for i := range sk {
+		sk[i] = byte(i * 3)
+	}
+	return sk
+}
+
+func fixtureCompressedSignature() CompressedSignature {
+	sig := make(CompressedSignature, 64)
+	for i := range sig {
+		sig[i] = byte(i * 7)
+	}
+	return sig
+}
+
+func fixtureCTSignature() CTSignature {
+	var sig CTSignature
+	for i := range sig {
+		sig[i] = byte(i * 5)
+	}
+	return sig
+}
+
+func TestPublicKeyPEMRoundTrip(t *testing.T) {
+	pk := fixturePublicKey()
+	armored, err := pk.MarshalPEM()
+	if err != nil {
+		t.Fatalf("MarshalPEM: %v", err)
+	}
+	got, err := UnmarshalPublicKeyPEM(armored)
+	if err != nil {
+		t.Fatalf("UnmarshalPublicKeyPEM: %v", err)
+	}
+	if !bytes.Equal(got, pk) {
+		t.Fatal("round-tripped public key does not match original")
+	}
+}
+
+func TestPrivateKeyPEMRoundTrip(t *testing.T) {
+	sk := fixturePrivateKey()
+	armored, err := sk.MarshalPEM()
+	if err != nil {
+		t.Fatalf("MarshalPEM: %v", err)
+	}
+	got, err := UnmarshalPrivateKeyPEM(armored)
+	if err != nil {
+		t.Fatalf("UnmarshalPrivateKeyPEM: %v", err)
+	}
+	if !bytes.Equal(got, sk) {
+		t.Fatal("round-tripped private key does not match original")
+	}
+}
+
+func TestCompressedSignaturePEMRoundTrip(t *testing.T) {
+	sig := fixtureCompressedSignature()
+	armored, err := sig.MarshalPEM()
+	if err != nil {
+		t.Fatalf("MarshalPEM: %v", err)
+	}
+	form, got, _, err := UnmarshalSignaturePEM(armored)
+	if err != nil {
+		t.Fatalf("UnmarshalSignaturePEM: %v", err)
+	}
+	if form != FormCompressed {
+		t.Fatalf("expected FormCompressed, got %v", form)
+	}
+	if !bytes.Equal(got, sig) {
+		t.Fatal("round-tripped compressed signature does not match original")
+	}
+}
+
+func TestCTSignaturePEMRoundTrip(t *testing.T) {
+	sig := fixtureCTSignature()
+	armored, err := sig.MarshalPEM()
+	if err != nil {
+		t.Fatalf("MarshalPEM: %v", err)
+	}
+	form, _, got, err := UnmarshalSignaturePEM(armored)
+	if err != nil {
+		t.Fatalf("UnmarshalSignaturePEM: %v", err)
+	}
+	if form != FormCT {
+		t.Fatalf("expected FormCT, got %v", form)
+	}
+	if got != sig {
+		t.Fatal("round-tripped CT signature does not match original")
+	}
+}
+
+func TestPEMChecksumDetectsCorruption(t *testing.T) {
+	pk := fixturePublicKey()
+	armored, err := pk.MarshalPEM()
+	if err != nil {
+		t.Fatalf("MarshalPEM: %v", err)
+	}
+
+	block, _ := pem.Decode(armored)
+	if block == nil {
+		t.Fatal("failed to decode PEM block")
+	}
+	block.Bytes[0] ^= 0xFF
+	corrupted := pem.EncodeToMemory(block)
+
+	if _, err := UnmarshalPublicKeyPEM(corrupted); !errors.Is(err, ErrArmorChecksum) {
+		t.Fatalf("expected ErrArmorChecksum, got %v", err)
+	}
+}
+
+func TestPublicKeyBinaryAndTextRoundTrip(t *testing.T) {
+	pk := fixturePublicKey()
+
+	data, err := pk.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	var gotBinary PublicKey
+	if err := gotBinary.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if !bytes.Equal(gotBinary, pk) {
+		t.Fatal("binary round trip does not match original")
+	}
+
+	text, err := pk.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	var gotText PublicKey
+	if err := gotText.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if !bytes.Equal(gotText, pk) {
+		t.Fatal("text round trip does not match original")
+	}
+}
+
+func TestPrivateKeyBinaryAndTextRoundTrip(t *testing.T) {
+	sk := fixturePrivateKey()
+
+	data, err := sk.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	var gotBinary PrivateKey
+	if err := gotBinary.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if !bytes.Equal(gotBinary, sk) {
+		t.Fatal("binary round trip does not match original")
+	}
+
+	text, err := sk.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	var gotText PrivateKey
+	if err := gotText.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if !bytes.Equal(gotText, sk) {
+		t.Fatal("text round trip does not match original")
+	}
+}
+
+func TestCompressedSignatureBinaryAndTextRoundTrip(t *testing.T) {
+	sig := fixtureCompressedSignature()
+
+	data, err := sig.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	var gotBinary CompressedSignature
+	if err := gotBinary.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if !bytes.Equal(gotBinary, sig) {
+		t.Fatal("binary round trip does not match original")
+	}
+
+	text, err := sig.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	var gotText CompressedSignature
+	if err := gotText.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if !bytes.Equal(gotText, sig) {
+		t.Fatal("text round trip does not match original")
+	}
+}
+
+func TestCTSignatureBinaryAndTextRoundTrip(t *testing.T) {
+	sig := fixtureCTSignature()
+
+	data, err := sig.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	var gotBinary CTSignature
+	if err := gotBinary.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if gotBinary != sig {
+		t.Fatal("binary round trip does not match original")
+	}
+
+	text, err := sig.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	var gotText CTSignature
+	if err := gotText.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if gotText != sig {
+		t.Fatal("text round trip does not match original")
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// batchOptions holds the configuration built up by BatchOption values.
+type batchOptions struct {
+	fastFail bool
+}
+
+// BatchOption configures VerifyBatch and VerifyBatchMulti.
+type BatchOption func(*batchOptions)
+
+// WithFastFail causes VerifyBatch/VerifyBatchMulti to stop scheduling new
+// work as soon as any signature is found invalid, instead of verifying
+// every element. The returned per-signature error slice may then contain
+// nils for elements that were never checked.
+func WithFastFail() BatchOption {
+	return func(o *batchOptions) { o.fastFail = true }
+}
+
+// VerifyBatch reports whether sigs[i] is a valid CT signature of msgs[i]
+// under pk, for every i. Verifications are spread across a worker pool
+// sized to GOMAXPROCS, since each falcon_det1024_verify_ct call is CPU-bound
+// and independent. By default every element is checked so callers get a
+// full per-signature error slice (e.g. to know exactly which signature in a
+// block was bad); pass WithFastFail to stop at the first failure instead.
+func (pk PublicKey) VerifyBatch(sigs []CTSignature, msgs [][]byte, opts ...BatchOption) (allOK bool, perSig []error) {
+	pks := make([]PublicKey, len(sigs))
+	for i := range pks {
+		pks[i] = pk
+	}
+	return VerifyBatchMulti(pks, sigs, msgs, opts...)
+}
+
+// VerifyBatchMulti is the multi-key variant of VerifyBatch: sigs[i] is
+// checked against msgs[i] under pks[i].
+func VerifyBatchMulti(pks []PublicKey, sigs []CTSignature, msgs [][]byte, opts ...BatchOption) (allOK bool, perSig []error) {
+	if len(pks) != len(sigs) || len(sigs) != len(msgs) {
+		err := fmt.Errorf("falcon: mismatched batch lengths: %d keys, %d sigs, %d msgs", len(pks), len(sigs), len(msgs))
+		perSig = make([]error, len(sigs))
+		for i := range perSig {
+			perSig[i] = err
+		}
+		return false, perSig
+	}
+
+	var o batchOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	perSig = make([]error, len(sigs))
+	if len(sigs) == 0 {
+		return true, perSig
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(sigs) {
+		workers = len(sigs)
+	}
+
+	var failed int32
+	var wg sync.WaitGroup
+	work := make(chan int)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				if o.fastFail && atomic.LoadInt32(&failed) != 0 {
+					continue
+				}
+				if err := pks[i].VerifyCTSignature(sigs[i], msgs[i]); err != nil {
+					perSig[i] = err
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := range sigs {
+		if o.fastFail && atomic.LoadInt32(&failed) != 0 {
+			break feed
+		}
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	allOK = true
+	for _, err := range perSig {
+		if err != nil {
+			allOK = false
+			break
+		}
+	}
+	return allOK, perSig
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import "testing"
+
+// batchBenchSize is large enough that a GOMAXPROCS-sized worker pool has
+// room to show a speedup over serial verification on a multi-core host.
+const batchBenchSize = 64
+
+func BenchmarkVerifyBatchSerial(b *testing.B) {
+	pks, sigs, msgs := buildBatch(b, batchBenchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range sigs {
+			if err := pks[j].VerifyCTSignature(sigs[j], msgs[j]); err != nil {
+				b.Fatalf("VerifyCTSignature: %v", err)
+			}
+		}
+	}
+}
+
+func BenchmarkVerifyBatchWorkerPool(b *testing.B) {
+	pks, sigs, msgs := buildBatch(b, batchBenchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if allOK, _ := VerifyBatchMulti(pks, sigs, msgs); !allOK {
+			b.Fatal("expected all signatures to verify")
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestVerifyBatchMultiMismatchedLengths(t *testing.T) {
+	pks := make([]PublicKey, 2)
+	sigs := make([]CTSignature, 1)
+	msgs := make([][]byte, 1)
+
+	allOK, perSig := VerifyBatchMulti(pks, sigs, msgs)
+	if allOK {
+		t.Fatal("expected allOK=false for mismatched batch lengths")
+	}
+	if len(perSig) != 1 || perSig[0] == nil {
+		t.Fatalf("expected a single populated error, got %#v", perSig)
+	}
+}
+
+func TestVerifyBatchEmpty(t *testing.T) {
+	var pk PublicKey
+	allOK, perSig := pk.VerifyBatch(nil, nil)
+	if !allOK || len(perSig) != 0 {
+		t.Fatalf("expected allOK=true and empty perSig for an empty batch, got %v %#v", allOK, perSig)
+	}
+}
+
+// buildBatch generates n independent key pairs, each signing its own
+// message, and returns them ready to feed into VerifyBatchMulti.
+func buildBatch(t testing.TB, n int) ([]PublicKey, []CTSignature, [][]byte) {
+	t.Helper()
+
+	pks := make([]PublicKey, n)
+	sigs := make([]CTSignature, n)
+	msgs := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		pk, sk, err := GenerateKey([]byte{byte(i)})
+		if err != nil {
+			t.Fatalf("GenerateKey: %v", err)
+		}
+		msg := []byte(fmt.Sprintf("falcon batch message %d", i))
+		sig, err := sk.SignCompressed(msg)
+		if err != nil {
+			t.Fatalf("SignCompressed: %v", err)
+		}
+		ctSig, err := sig.ConvertToCT()
+		if err != nil {
+			t.Fatalf("ConvertToCT: %v", err)
+		}
+		pks[i], sigs[i], msgs[i] = pk, ctSig, msg
+	}
+	return pks, sigs, msgs
+}
+
+func TestVerifyBatchMultiRoundTrip(t *testing.T) {
+	pks, sigs, msgs := buildBatch(t, 4)
+
+	allOK, perSig := VerifyBatchMulti(pks, sigs, msgs)
+	if !allOK {
+		t.Fatalf("expected all signatures to verify, got errors %v", perSig)
+	}
+	for i, err := range perSig {
+		if err != nil {
+			t.Fatalf("unexpected error for signature %d: %v", i, err)
+		}
+	}
+
+	// Corrupt one signature and confirm VerifyBatchMulti reports exactly which one.
+	sigs[1][0] ^= 0xFF
+	allOK, perSig = VerifyBatchMulti(pks, sigs, msgs)
+	if allOK {
+		t.Fatal("expected corrupted signature to fail verification")
+	}
+	for i, err := range perSig {
+		if i == 1 && err == nil {
+			t.Fatal("expected an error for the corrupted signature")
+		}
+		if i != 1 && err != nil {
+			t.Fatalf("unexpected error for signature %d: %v", i, err)
+		}
+	}
+
+	// WithFastFail still reports the failure it observed.
+	allOK, perSig = VerifyBatchMulti(pks, sigs, msgs, WithFastFail())
+	if allOK {
+		t.Fatal("expected allOK=false with a corrupted signature under WithFastFail")
+	}
+	if !errors.Is(perSig[1], ErrVerifyFail) {
+		t.Fatalf("expected ErrVerifyFail for the corrupted signature, got %v", perSig[1])
+	}
+}
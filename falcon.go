@@ -38,13 +38,25 @@ var (
 	ErrSignFail    = errors.New("falcon sign failed")
 	ErrVerifyFail  = errors.New("falcon verify failed")
 	ErrConvertFail = errors.New("falcon convert to CT failed")
+	// ErrBadPublicKeyLength is returned when a PublicKey does not have length PublicKeySize.
+	ErrBadPublicKeyLength = errors.New("falcon: bad public key length")
+	// ErrBadPrivateKeyLength is returned when a PrivateKey does not have length ExpandedPrivateKeySize.
+	ErrBadPrivateKeyLength = errors.New("falcon: bad private key length")
 )
 
 const (
 	// PublicKeySize is the size of a Falcon public key.
 	PublicKeySize = C.FALCON_DET1024_PUBKEY_SIZE
-	// PrivateKeySize is the size of a Falcon private key.
+	// PrivateKeySize is the size of a Falcon private key, exactly as produced
+	// and consumed by the underlying C implementation. This value is
+	// unchanged from prior releases; it does not include the embedded
+	// PublicKey copy described on PrivateKey (see ExpandedPrivateKeySize).
 	PrivateKeySize = C.FALCON_DET1024_PRIVKEY_SIZE
+	// ExpandedPrivateKeySize is the length of our in-memory PrivateKey
+	// representation: the raw PrivateKeySize signing key followed by its
+	// corresponding PublicKey (mirroring crypto/ed25519.PrivateKey), so that
+	// Public can be implemented without recomputing the key pair.
+	ExpandedPrivateKeySize = PrivateKeySize + PublicKeySize
 	// CurrentSaltVersion is the salt version number used to compute signatures.
 	// The salt version is incremented when the signing procedure changes (rarely).
 	CurrentSaltVersion = C.FALCON_DET1024_CURRENT_SALT_VERSION
@@ -54,11 +66,16 @@ const (
 	SignatureMaxSize = C.FALCON_DET1024_SIG_COMPRESSED_MAXSIZE
 )
 
-// PublicKey represents  a falcon public key
-type PublicKey [PublicKeySize]byte
+// PublicKey represents a falcon public key. It is a []byte so that it can be
+// returned as a crypto.PublicKey and marshaled like other standard library
+// key types; a valid PublicKey always has length PublicKeySize.
+type PublicKey []byte
 
-// PrivateKey represents  a falcon private key
-type PrivateKey [PrivateKeySize]byte
+// PrivateKey represents a falcon private key. It is a []byte: the raw
+// PrivateKeySize signing key produced by GenerateKey followed by the
+// embedded PublicKey, in the same spirit as crypto/ed25519.PrivateKey. A
+// valid PrivateKey always has length ExpandedPrivateKeySize.
+type PrivateKey []byte
 
 // CompressedSignature is a deterministic Falcon signature in compressed
 // form, which is variable-length.
@@ -79,21 +96,26 @@ func GenerateKey(seed []byte) (PublicKey, PrivateKey, error) {
 	}
 	C.shake256_init_prng_from_seed(&rng, unsafe.Pointer(seedData), C.size_t(seedLen))
 
-	publicKey := PublicKey{}
-	privateKey := PrivateKey{}
+	publicKey := make(PublicKey, PublicKeySize)
+	privateKey := make(PrivateKey, ExpandedPrivateKeySize)
 
 	r := C.falcon_det1024_keygen(&rng, unsafe.Pointer(&privateKey[0]), unsafe.Pointer(&publicKey[0]))
 	if r != 0 {
-		return PublicKey{}, PrivateKey{}, fmt.Errorf("error code is %d: %w", int(r), ErrKeygenFail)
+		return nil, nil, fmt.Errorf("error code is %d: %w", int(r), ErrKeygenFail)
 	}
+	copy(privateKey[PrivateKeySize:], publicKey)
 
 	runtime.KeepAlive(seed)
 	return publicKey, privateKey, nil
 }
 
-// SignCompressed signs the message with privateKey and returns a compressed
+// SignCompressed signs the message with sk and returns a compressed
 // signature, or an error if signing fails (e.g., due to a malformed private key).
-func (sk *PrivateKey) SignCompressed(msg []byte) (CompressedSignature, error) {
+func (sk PrivateKey) SignCompressed(msg []byte) (CompressedSignature, error) {
+	if len(sk) != ExpandedPrivateKeySize {
+		return nil, fmt.Errorf("%w: %d", ErrBadPrivateKeyLength, len(sk))
+	}
+
 	msgLen := len(msg)
 	cdata := (*C.uchar)(C.NULL)
 	if msgLen > 0 {
@@ -102,12 +124,13 @@ func (sk *PrivateKey) SignCompressed(msg []byte) (CompressedSignature, error) {
 
 	var sigLen C.size_t
 	var sig [SignatureMaxSize]byte
-	r := C.falcon_det1024_sign_compressed(unsafe.Pointer(&sig[0]), &sigLen, unsafe.Pointer(&(*sk)), unsafe.Pointer(cdata), C.size_t(msgLen))
+	r := C.falcon_det1024_sign_compressed(unsafe.Pointer(&sig[0]), &sigLen, unsafe.Pointer(&sk[0]), unsafe.Pointer(cdata), C.size_t(msgLen))
 	if r != 0 {
 		return nil, fmt.Errorf("error code %d: %w", int(r), ErrSignFail)
 	}
 
 	runtime.KeepAlive(msg)
+	runtime.KeepAlive(sk)
 	return sig[:sigLen], nil
 }
 
@@ -122,8 +145,12 @@ func (sig *CompressedSignature) ConvertToCT() (CTSignature, error) {
 	return sigCT, nil
 }
 
-// Verify reports whether sig is a valid compressed signature of msg under publicKey.
-func (pk *PublicKey) Verify(signature CompressedSignature, msg []byte) error {
+// Verify reports whether sig is a valid compressed signature of msg under pk.
+func (pk PublicKey) Verify(signature CompressedSignature, msg []byte) error {
+	if len(pk) != PublicKeySize {
+		return fmt.Errorf("%w: %d", ErrBadPublicKeyLength, len(pk))
+	}
+
 	msgLen := len(msg)
 	msgData := C.NULL
 	if msgLen > 0 {
@@ -136,29 +163,35 @@ func (pk *PublicKey) Verify(signature CompressedSignature, msg []byte) error {
 		sigData = unsafe.Pointer(&signature[0])
 	}
 
-	r := C.falcon_det1024_verify_compressed(sigData, C.size_t(sigLen), unsafe.Pointer(&(*pk)), msgData, C.size_t(msgLen))
+	r := C.falcon_det1024_verify_compressed(sigData, C.size_t(sigLen), unsafe.Pointer(&pk[0]), msgData, C.size_t(msgLen))
 	if r != 0 {
 		return fmt.Errorf("error code %d: %w", int(r), ErrVerifyFail)
 	}
 
 	runtime.KeepAlive(msg)
 	runtime.KeepAlive(signature)
+	runtime.KeepAlive(pk)
 	return nil
 }
 
-// VerifyCTSignature reports whether sig is a valid CT signature of msg under publicKey.
-func (pk *PublicKey) VerifyCTSignature(signature CTSignature, msg []byte) error {
+// VerifyCTSignature reports whether sig is a valid CT signature of msg under pk.
+func (pk PublicKey) VerifyCTSignature(signature CTSignature, msg []byte) error {
+	if len(pk) != PublicKeySize {
+		return fmt.Errorf("%w: %d", ErrBadPublicKeyLength, len(pk))
+	}
+
 	data := C.NULL
 	if len(msg) > 0 {
 		data = unsafe.Pointer(&msg[0])
 	}
-	r := C.falcon_det1024_verify_ct(unsafe.Pointer(&signature[0]), unsafe.Pointer(&(*pk)), data, C.size_t(len(msg)))
+	r := C.falcon_det1024_verify_ct(unsafe.Pointer(&signature[0]), unsafe.Pointer(&pk[0]), data, C.size_t(len(msg)))
 	if r != 0 {
 		return fmt.Errorf("error code %d: %w", int(r), ErrVerifyFail)
 	}
 
 	runtime.KeepAlive(msg)
 	runtime.KeepAlive(signature)
+	runtime.KeepAlive(pk)
 	return nil
 }
 
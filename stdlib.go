@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import (
+	"crypto"
+	"errors"
+	"io"
+)
+
+// SeedSize is the size in bytes of the seed consumed by GenerateKeyReader.
+const SeedSize = 32
+
+// SignatureForm selects the wire format Sign produces for a given call.
+type SignatureForm int
+
+const (
+	// FormCompressed selects a variable-length CompressedSignature. This is
+	// the default form used when opts does not specify otherwise.
+	FormCompressed SignatureForm = iota
+	// FormCT selects a fixed-length CTSignature.
+	FormCT
+)
+
+// SignerOpts implements crypto.SignerOpts for Falcon keys. It lets callers
+// of PrivateKey.Sign pick between the compressed and constant-time signature
+// forms. The zero value selects FormCompressed with no pre-hashing.
+type SignerOpts struct {
+	// Form selects the signature form Sign returns.
+	Form SignatureForm
+	// Hash, if non-zero, indicates that the digest passed to Sign is already
+	// the output of this hash function rather than the raw message.
+	Hash crypto.Hash
+}
+
+// HashFunc implements crypto.SignerOpts.
+func (o SignerOpts) HashFunc() crypto.Hash {
+	return o.Hash
+}
+
+// ErrPrehashedSignUnsupported is returned by Sign when opts requests
+// pre-hashed signing, which this package does not yet implement.
+var ErrPrehashedSignUnsupported = errors.New("falcon: pre-hashed signing is not supported")
+
+// Public returns the PublicKey embedded in sk, implementing crypto.Signer.
+// The crypto.Signer interface leaves Public no way to report an error, so
+// unlike SignCompressed, Verify and Sign, a malformed sk yields a nil
+// PublicKey rather than an error or a panic; callers that accept untrusted
+// PrivateKey values should check sk's length themselves before relying on
+// Public.
+func (sk PrivateKey) Public() crypto.PublicKey {
+	if len(sk) != ExpandedPrivateKeySize {
+		return PublicKey(nil)
+	}
+	pub := make(PublicKey, PublicKeySize)
+	copy(pub, sk[PrivateKeySize:])
+	return pub
+}
+
+// Sign signs digest with sk and implements crypto.Signer. rand is ignored,
+// as Falcon signing is deterministic. opts may be a SignerOpts to select the
+// signature form; any other crypto.SignerOpts is treated as FormCompressed.
+func (sk PrivateKey) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var so SignerOpts
+	if o, ok := opts.(SignerOpts); ok {
+		so = o
+	} else if opts != nil {
+		so.Hash = opts.HashFunc()
+	}
+	if so.Hash != crypto.Hash(0) {
+		return nil, ErrPrehashedSignUnsupported
+	}
+
+	sig, err := sk.SignCompressed(digest)
+	if err != nil {
+		return nil, err
+	}
+	if so.Form == FormCT {
+		ctSig, err := sig.ConvertToCT()
+		if err != nil {
+			return nil, err
+		}
+		return ctSig[:], nil
+	}
+	return sig, nil
+}
+
+// Verifier reports whether sig is a valid signature of msg under pk, picking
+// the signature form the same way Sign does via opts. It is the verification
+// counterpart to PrivateKey.Sign.
+func (pk PublicKey) Verifier(sig []byte, msg []byte, opts crypto.SignerOpts) error {
+	var so SignerOpts
+	if o, ok := opts.(SignerOpts); ok {
+		so = o
+	}
+
+	if so.Form == FormCT {
+		var ctSig CTSignature
+		if len(sig) != len(ctSig) {
+			return ErrVerifyFail
+		}
+		copy(ctSig[:], sig)
+		return pk.VerifyCTSignature(ctSig, msg)
+	}
+	return pk.Verify(CompressedSignature(sig), msg)
+}
+
+// GenerateKeyReader generates a public/private key pair, reading SeedSize
+// bytes of entropy from rand, mirroring crypto/ed25519.GenerateKey.
+func GenerateKeyReader(rand io.Reader) (PublicKey, PrivateKey, error) {
+	seed := make([]byte, SeedSize)
+	if _, err := io.ReadFull(rand, seed); err != nil {
+		return nil, nil, err
+	}
+	return GenerateKey(seed)
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"testing"
+)
+
+// PrivateKey must satisfy crypto.Signer for this package to drop into code
+// that expects one (TLS callbacks, JOSE libraries, x509 signing, etc.).
+var _ crypto.Signer = PrivateKey{}
+
+func TestPrivateKeySignVerifierRoundTrip(t *testing.T) {
+	pk, sk, err := GenerateKey([]byte("stdlib-test-seed"))
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	msg := []byte("sign me")
+
+	compressedSig, err := sk.Sign(nil, msg, SignerOpts{Form: FormCompressed})
+	if err != nil {
+		t.Fatalf("Sign(FormCompressed): %v", err)
+	}
+	if err := pk.Verifier(compressedSig, msg, SignerOpts{Form: FormCompressed}); err != nil {
+		t.Fatalf("Verifier(FormCompressed): %v", err)
+	}
+
+	ctSig, err := sk.Sign(nil, msg, SignerOpts{Form: FormCT})
+	if err != nil {
+		t.Fatalf("Sign(FormCT): %v", err)
+	}
+	if err := pk.Verifier(ctSig, msg, SignerOpts{Form: FormCT}); err != nil {
+		t.Fatalf("Verifier(FormCT): %v", err)
+	}
+
+	// Calling through the crypto.Signer interface with a bare crypto.Hash(0)
+	// (no SignerOpts) must default to FormCompressed.
+	var signer crypto.Signer = sk
+	defaultSig, err := signer.Sign(nil, msg, crypto.Hash(0))
+	if err != nil {
+		t.Fatalf("Sign via crypto.Signer: %v", err)
+	}
+	if err := pk.Verifier(defaultSig, msg, SignerOpts{}); err != nil {
+		t.Fatalf("Verifier of default-form signature: %v", err)
+	}
+}
+
+func TestPrivateKeySignRejectsPrehashed(t *testing.T) {
+	_, sk, err := GenerateKey([]byte("stdlib-test-seed-2"))
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	_, err = sk.Sign(nil, make([]byte, 32), crypto.SHA256)
+	if !errors.Is(err, ErrPrehashedSignUnsupported) {
+		t.Fatalf("expected ErrPrehashedSignUnsupported, got %v", err)
+	}
+}
+
+func TestPrivateKeyPublic(t *testing.T) {
+	pk, sk, err := GenerateKey([]byte("stdlib-test-seed-3"))
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	got, ok := sk.Public().(PublicKey)
+	if !ok {
+		t.Fatalf("Public() returned %T, want PublicKey", sk.Public())
+	}
+	if !bytes.Equal(got, pk) {
+		t.Fatal("Public() does not match the key pair's PublicKey")
+	}
+
+	var bad PrivateKey
+	pub, ok := bad.Public().(PublicKey)
+	if !ok || pub != nil {
+		t.Fatalf("expected a nil PublicKey for a malformed PrivateKey, got %#v", pub)
+	}
+}
+
+func TestGenerateKeyReader(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x42}, SeedSize)
+	wantPK, wantSK, err := GenerateKey(seed)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	gotPK, gotSK, err := GenerateKeyReader(bytes.NewReader(seed))
+	if err != nil {
+		t.Fatalf("GenerateKeyReader: %v", err)
+	}
+	if !bytes.Equal(gotPK, wantPK) || !bytes.Equal(gotSK, wantSK) {
+		t.Fatal("GenerateKeyReader did not match GenerateKey given the same seed bytes")
+	}
+}
+
+func TestGenerateKeyReaderShortReader(t *testing.T) {
+	if _, _, err := GenerateKeyReader(bytes.NewReader(nil)); err == nil {
+		t.Fatal("expected an error reading a seed from an empty source")
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package falcon
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSignStreamMatchesSignCompressedOnDigest(t *testing.T) {
+	pk, sk, err := GenerateKey([]byte("stream-test-seed"))
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	msg := []byte("a streamed message, signed via its SHA-512 digest")
+	streamSig, err := sk.SignStream(bytes.NewReader(msg))
+	if err != nil {
+		t.Fatalf("SignStream: %v", err)
+	}
+
+	digest, err := hashReader(bytes.NewReader(msg))
+	if err != nil {
+		t.Fatalf("hashReader: %v", err)
+	}
+	directSig, err := sk.SignCompressed(digest)
+	if err != nil {
+		t.Fatalf("SignCompressed: %v", err)
+	}
+	if !bytes.Equal(streamSig, directSig) {
+		t.Fatal("SignStream did not sign the same digest as SignCompressed(hashReader(msg))")
+	}
+
+	if err := pk.VerifyStream(streamSig, bytes.NewReader(msg)); err != nil {
+		t.Fatalf("VerifyStream: %v", err)
+	}
+	if err := pk.Verify(streamSig, digest); err != nil {
+		t.Fatalf("Verify of streamSig against the digest: %v", err)
+	}
+}
+
+func TestVerifyStreamRejectsTamperedMessage(t *testing.T) {
+	pk, sk, err := GenerateKey([]byte("stream-test-seed-2"))
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	sig, err := sk.SignStream(bytes.NewReader([]byte("original message")))
+	if err != nil {
+		t.Fatalf("SignStream: %v", err)
+	}
+
+	if err := pk.VerifyStream(sig, bytes.NewReader([]byte("tampered message"))); err == nil {
+		t.Fatal("expected VerifyStream to reject a tampered message")
+	}
+}
+
+func TestSignStreamCTRoundTrip(t *testing.T) {
+	pk, sk, err := GenerateKey([]byte("stream-test-seed-3"))
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	sig, err := sk.SignStreamCT(bytes.NewReader([]byte("CT streamed message")))
+	if err != nil {
+		t.Fatalf("SignStreamCT: %v", err)
+	}
+	if err := pk.VerifyStreamCT(sig, bytes.NewReader([]byte("CT streamed message"))); err != nil {
+		t.Fatalf("VerifyStreamCT: %v", err)
+	}
+
+	if err := pk.VerifyStreamCT(sig, bytes.NewReader([]byte("different message"))); err == nil {
+		t.Fatal("expected VerifyStreamCT to reject a tampered message")
+	}
+}